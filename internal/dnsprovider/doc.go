@@ -0,0 +1,7 @@
+// Package dnsprovider anchors this module's dependency on the vendored
+// PowerDNS client so go mod tidy/vendor keep tracking it even before the
+// provider implementation that will live here is ported over from upstream
+// external-dns.
+package dnsprovider
+
+import _ "github.com/dmportella/powerdns"
@@ -0,0 +1,42 @@
+package powerdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestApplyMulti_PartialFailure verifies that a failing zone's error is
+// reported without preventing the other zones' ChangeSets from being applied.
+func TestApplyMulti_PartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "bad.example.com.") {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"error": "zone not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "secret", WithAPIVersion(1))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	changes := map[string]*ChangeSet{
+		"good.example.com.": NewChangeSet().Add(Record{Name: "www.good.example.com.", Type: "A", Content: "203.0.113.1", TTL: 300}),
+		"bad.example.com.":  NewChangeSet().Add(Record{Name: "www.bad.example.com.", Type: "A", Content: "203.0.113.2", TTL: 300}),
+	}
+
+	results := client.ApplyMulti(context.Background(), changes)
+
+	if err := results["good.example.com."]; err != nil {
+		t.Fatalf("good.example.com. should have applied, got error: %v", err)
+	}
+	if err := results["bad.example.com."]; err == nil {
+		t.Fatal("bad.example.com. should have returned an error")
+	}
+}
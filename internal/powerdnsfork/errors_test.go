@@ -0,0 +1,62 @@
+package powerdns
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func decodeErrorFromBody(statusCode int, body string) error {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	return decodeError(resp)
+}
+
+func TestDecodeError_LegacyShape(t *testing.T) {
+	err := decodeErrorFromBody(http.StatusNotFound, `{"error": "zone not found"}`)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("decodeError returned %T, want *APIError", err)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0] != "zone not found" {
+		t.Fatalf("Errors = %v, want [\"zone not found\"]", apiErr.Errors)
+	}
+	if !errors.Is(err, ErrZoneNotFound) {
+		t.Fatal("expected errors.Is(err, ErrZoneNotFound) to match")
+	}
+}
+
+func TestDecodeError_V1Shape(t *testing.T) {
+	err := decodeErrorFromBody(http.StatusUnprocessableEntity, `{"error": "Validation Failure", "errors": ["ttl must be positive", "name is required"]}`)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("decodeError returned %T, want *APIError", err)
+	}
+	if len(apiErr.Errors) != 2 {
+		t.Fatalf("Errors = %v, want 2 entries", apiErr.Errors)
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Fatal("expected errors.Is(err, ErrValidation) to match")
+	}
+}
+
+func TestDecodeError_EmptyBody(t *testing.T) {
+	err := decodeErrorFromBody(http.StatusUnauthorized, ``)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("decodeError returned %T, want *APIError", err)
+	}
+	if len(apiErr.Errors) != 0 {
+		t.Fatalf("Errors = %v, want none", apiErr.Errors)
+	}
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatal("expected errors.Is(err, ErrUnauthorized) to match")
+	}
+}
@@ -0,0 +1,785 @@
+package powerdns
+
+// Covered by original license.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// DefaultTimeout is the per-request timeout applied when no Option overrides it.
+const DefaultTimeout = 30 * time.Second
+
+// DefaultMaxRetries is the number of retry attempts applied to requests that
+// fail with a 429 or 5xx response, or a transient network error.
+const DefaultMaxRetries = 3
+
+// defaultRetryBackoff is the base delay used for exponential backoff between
+// retries when the server does not supply a Retry-After header.
+const defaultRetryBackoff = 500 * time.Millisecond
+
+// DefaultServerID is the PowerDNS server identifier used when no
+// WithServerID Option is given. Single-server deployments are always
+// addressable as "localhost".
+const DefaultServerID = "localhost"
+
+// apiVersionReprobeInterval governs how long a detected API version is
+// trusted before detectapiVersion is asked to confirm it again, so a
+// rolling 3.4 -> 4.x upgrade of the PowerDNS server is picked up without
+// restarting the process. It has no effect when the version was pinned via
+// WithAPIVersion.
+const apiVersionReprobeInterval = 5 * time.Minute
+
+// Client Powerdns API client.
+type Client struct {
+	serverURL    string
+	serverID     string
+	apiKey       string
+	http         *http.Client
+	timeout      time.Duration
+	maxRetries   int
+	retryBackoff time.Duration
+
+	apiVersionPinned bool
+
+	apiVersionMu       sync.RWMutex
+	apiVersion         int
+	apiVersionProbedAt time.Time
+
+	verifyOpts *VerifyOptions
+}
+
+// Option configures optional behavior of a Client created via NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used for all requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(client *Client) {
+		client.http = hc
+	}
+}
+
+// WithTimeout sets the per-request timeout applied to every call that is not
+// given an explicit deadline via its context.
+func WithTimeout(timeout time.Duration) Option {
+	return func(client *Client) {
+		client.timeout = timeout
+	}
+}
+
+// WithMaxRetries sets how many times a request is retried after a 429, 5xx,
+// or transient network error before the failure is returned to the caller.
+func WithMaxRetries(maxRetries int) Option {
+	return func(client *Client) {
+		client.maxRetries = maxRetries
+	}
+}
+
+// WithServerID overrides the PowerDNS server identifier addressed by every
+// request (the "localhost" in /servers/localhost/...). This matters for
+// federated or virtual-hosted PowerDNS deployments exposing more than one
+// server through the same API.
+func WithServerID(serverID string) Option {
+	return func(client *Client) {
+		client.serverID = serverID
+	}
+}
+
+// WithAPIVersion pins the API version to use instead of probing for it,
+// useful for air-gapped setups or when the probe endpoint is unreachable
+// through a proxy. Pass 0 for the legacy (3.4) API, 1 for the current one.
+func WithAPIVersion(version int) Option {
+	return func(client *Client) {
+		client.apiVersionPinned = true
+		client.apiVersion = version
+	}
+}
+
+// NewClient returns a new PowerDNS client
+func NewClient(serverURL string, apiKey string, opts ...Option) (*Client, error) {
+	url, err := url.Parse(serverURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	url.Path = ""
+
+	client := Client{
+		serverURL:    url.String(),
+		serverID:     DefaultServerID,
+		apiKey:       apiKey,
+		http:         cleanhttp.DefaultClient(),
+		timeout:      DefaultTimeout,
+		maxRetries:   DefaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(&client)
+	}
+
+	if !client.apiVersionPinned {
+		version, err := client.detectapiVersion(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		client.apiVersion = version
+		client.apiVersionProbedAt = time.Now()
+	}
+
+	return &client, nil
+}
+
+// Detects the API version in use on the server
+// Uses int to represent the API version: 0 is the legacy AKA version 3.4 API
+// Any other integer correlates with the same API version
+func (client *Client) detectapiVersion(ctx context.Context) (int, error) {
+
+	req, err := client.newRequestForVersion(ctx, 1, "GET", "/servers", nil, nil)
+
+	if err != nil {
+		return -1, err
+	}
+
+	resp, err := client.do(req)
+
+	if err != nil {
+		return -1, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 200 {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// currentAPIVersion returns the API version to address requests with,
+// transparently re-probing the server once apiVersionReprobeInterval has
+// elapsed since the last check. Pinned clients never re-probe. A failed
+// re-probe is ignored and the last known-good version is kept, since a
+// momentary probe failure shouldn't disrupt in-flight requests.
+func (client *Client) currentAPIVersion(ctx context.Context) int {
+	if client.apiVersionPinned {
+		return client.apiVersion
+	}
+
+	client.apiVersionMu.RLock()
+	version := client.apiVersion
+	stale := time.Since(client.apiVersionProbedAt) > apiVersionReprobeInterval
+	client.apiVersionMu.RUnlock()
+
+	if !stale {
+		return version
+	}
+
+	if detected, err := client.detectapiVersion(ctx); err == nil {
+		client.apiVersionMu.Lock()
+		client.apiVersion = detected
+		client.apiVersionProbedAt = time.Now()
+		version = detected
+		client.apiVersionMu.Unlock()
+	} else {
+		client.apiVersionMu.Lock()
+		client.apiVersionProbedAt = time.Now()
+		client.apiVersionMu.Unlock()
+	}
+
+	return version
+}
+
+// zonesPath returns the path of the zones collection on this client's
+// server, honoring a WithServerID override of the default "localhost".
+func (client *Client) zonesPath() string {
+	return fmt.Sprintf("/servers/%s/zones", client.serverID)
+}
+
+// zonePath returns the path of a single zone, optionally joined with
+// additional sub-resource segments (e.g. "metadata", "notify").
+func (client *Client) zonePath(zone string, subresource ...string) string {
+	segments := append([]string{client.zonesPath(), zone}, subresource...)
+	return path.Join(segments...)
+}
+
+// Creates a new request with necessary headers, bound to ctx so the caller
+// can cancel or time out the call.
+func (client *Client) newRequest(ctx context.Context, method string, endpoint string, body []byte) (*http.Request, error) {
+	return client.newRequestWithQuery(ctx, method, endpoint, nil, body)
+}
+
+// newRequestWithQuery is like newRequest but additionally sets query, which
+// lets callers ask the server to filter the response (e.g. rrset_name and
+// rrset_type) instead of downloading the full zone.
+func (client *Client) newRequestWithQuery(ctx context.Context, method string, endpoint string, query url.Values, body []byte) (*http.Request, error) {
+	return client.newRequestForVersion(ctx, client.currentAPIVersion(ctx), method, endpoint, query, body)
+}
+
+// newRequestForVersion is newRequestWithQuery but addresses a specific API
+// version directly, bypassing currentAPIVersion. It is used by
+// detectapiVersion itself, which must not recurse into the probe it is
+// performing.
+func (client *Client) newRequestForVersion(ctx context.Context, apiVersion int, method string, endpoint string, query url.Values, body []byte) (*http.Request, error) {
+	url, err := url.Parse(client.serverURL)
+
+	if apiVersion > 0 {
+		url.Path = path.Join("/api/v"+strconv.Itoa(apiVersion), endpoint)
+	} else {
+		url.Path = path.Join(url.Path, endpoint)
+	}
+
+	if len(query) > 0 {
+		url.RawQuery = query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("Error during creation of request: %s", err)
+	}
+
+	req.Header.Add("X-API-Key", client.apiKey)
+	req.Header.Add("Accept", "application/json")
+
+	if method != "GET" {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// do executes req, applying the client's per-request timeout and retrying
+// on 429/5xx responses and transient network errors with exponential
+// backoff and jitter. A 429 response's Retry-After header, if present,
+// takes precedence over the computed backoff.
+func (client *Client) do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if client.timeout > 0 {
+		if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, client.timeout)
+			defer cancel()
+			req = req.WithContext(ctx)
+		}
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		resp, err = client.http.Do(req)
+		if err != nil {
+			if ctx.Err() != nil || attempt >= client.maxRetries {
+				return nil, err
+			}
+		} else if !shouldRetry(resp.StatusCode) || attempt >= client.maxRetries {
+			return resp, nil
+		} else {
+			resp.Body.Close()
+		}
+
+		wait := retryDelay(resp, attempt, client.retryBackoff)
+		resp = nil
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = io.NopCloser(body)
+		}
+	}
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay computes how long to wait before the next attempt, honoring a
+// Retry-After header on 429 responses and otherwise backing off
+// exponentially with full jitter.
+func retryDelay(resp *http.Response, attempt int, base time.Duration) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	backoff := base << uint(attempt)
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// ZoneInfo Data representing Zone Information.
+type ZoneInfo struct {
+	ID                 string              `json:"ID"`
+	Name               string              `json:"name"`
+	Account            string              `json:"account"`
+	URL                string              `json:"url"`
+	LastCheck          int64               `json:"last_check"`
+	Kind               string              `json:"kind"`
+	DNSSec             bool                `json:"dnsssec"`
+	Serial             int64               `json:"serial"`
+	NotifiedSerial     int64               `json:"notified_serial"`
+	Masters            []string            `json:"masters"`
+	Records            []Record            `json:"records,omitempty"`
+	ResourceRecordSets []ResourceRecordSet `json:"rrsets,omitempty"`
+}
+
+// Record Data representing Record Information.
+type Record struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Content  string `json:"content"`
+	TTL      int    `json:"ttl"` // For API v0
+	Disabled bool   `json:"disabled"`
+}
+
+// ResourceRecordSet Data representing Resource Record Set Information.
+type ResourceRecordSet struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	ChangeType string   `json:"changetype"`
+	TTL        int      `json:"ttl"` // For API v1
+	Records    []Record `json:"records,omitempty"`
+}
+
+type zonePatchRequest struct {
+	RecordSets []ResourceRecordSet `json:"rrsets"`
+}
+
+// IDSeparator separator for record identifier.
+const IDSeparator string = ":::"
+
+// ID Returns the record identifier.
+func (record *Record) ID() string {
+	return record.Name + IDSeparator + record.Type
+}
+
+// ID Returns the resource record identifier.
+func (rrSet *ResourceRecordSet) ID() string {
+	return rrSet.Name + IDSeparator + rrSet.Type
+}
+
+// Returns name and type of record or record set based on it's ID
+func parseID(recID string) (string, string, error) {
+	s := strings.Split(recID, IDSeparator)
+
+	if len(s) == 2 {
+		return s[0], s[1], nil
+	}
+
+	return "", "", fmt.Errorf("Unknown record ID format")
+}
+
+// ListZones Returns all Zones of server, without records
+func (client *Client) ListZones() ([]ZoneInfo, error) {
+	return client.ListZonesWithContext(context.Background())
+}
+
+// ListZonesWithContext Returns all Zones of server, without records
+func (client *Client) ListZonesWithContext(ctx context.Context) ([]ZoneInfo, error) {
+
+	req, err := client.newRequest(ctx, "GET", client.zonesPath(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var zoneInfos []ZoneInfo
+
+	err = json.NewDecoder(resp.Body).Decode(&zoneInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return zoneInfos, nil
+}
+
+// ListRecords Returns all records in Zone
+func (client *Client) ListRecords(zone string) ([]Record, error) {
+	return client.ListRecordsWithContext(context.Background(), zone)
+}
+
+// ListRecordsWithContext Returns all records in Zone
+func (client *Client) ListRecordsWithContext(ctx context.Context, zone string) ([]Record, error) {
+	req, err := client.newRequest(ctx, "GET", client.zonePath(zone), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	zoneInfo := new(ZoneInfo)
+	err = json.NewDecoder(resp.Body).Decode(zoneInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	records := zoneInfo.Records
+	// Convert the API v1 response to v0 record structure
+	for _, rrs := range zoneInfo.ResourceRecordSets {
+		for _, record := range rrs.Records {
+			records = append(records, Record{
+				Name:    rrs.Name,
+				Type:    rrs.Type,
+				Content: record.Content,
+				TTL:     rrs.TTL,
+			})
+		}
+	}
+
+	return records, nil
+}
+
+// ListRecordsAsRRSet Returns only records of specified name and type
+func (client *Client) ListRecordsAsRRSet(zone string) ([]ResourceRecordSet, error) {
+	return client.ListRecordsAsRRSetWithContext(context.Background(), zone)
+}
+
+// ListRecordsAsRRSetWithContext Returns only records of specified name and type
+func (client *Client) ListRecordsAsRRSetWithContext(ctx context.Context, zone string) ([]ResourceRecordSet, error) {
+	req, err := client.newRequest(ctx, "GET", client.zonePath(zone), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	zoneInfo := new(ZoneInfo)
+	err = json.NewDecoder(resp.Body).Decode(zoneInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if zoneInfo.ResourceRecordSets == nil && len(zoneInfo.ResourceRecordSets) == 0 {
+		return nil, nil
+	}
+
+	return zoneInfo.ResourceRecordSets, nil
+}
+
+// GetRecordSet returns only the rrset matching name and type, using the
+// server's rrset_name/rrset_type query filter so the full zone is not
+// downloaded. It returns nil if no such rrset exists.
+func (client *Client) GetRecordSet(zone string, name string, tpe string) (*ResourceRecordSet, error) {
+	return client.GetRecordSetWithContext(context.Background(), zone, name, tpe)
+}
+
+// GetRecordSetWithContext is GetRecordSet with a caller-supplied context.
+func (client *Client) GetRecordSetWithContext(ctx context.Context, zone string, name string, tpe string) (*ResourceRecordSet, error) {
+	query := url.Values{
+		"rrset_name": {name},
+		"rrset_type": {tpe},
+	}
+
+	req, err := client.newRequestWithQuery(ctx, "GET", client.zonePath(zone), query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	zoneInfo := new(ZoneInfo)
+	if err := json.NewDecoder(resp.Body).Decode(zoneInfo); err != nil {
+		return nil, err
+	}
+
+	for _, rrs := range zoneInfo.ResourceRecordSets {
+		if rrs.Name == name && rrs.Type == tpe {
+			return &rrs, nil
+		}
+	}
+
+	// Legacy (v0 / 3.4) servers ignore rrset_name/rrset_type and return
+	// the zone's flat Records list instead of ResourceRecordSets.
+	var matched *ResourceRecordSet
+	for _, record := range zoneInfo.Records {
+		if record.Name != name || record.Type != tpe {
+			continue
+		}
+
+		if matched == nil {
+			matched = &ResourceRecordSet{Name: record.Name, Type: record.Type, TTL: record.TTL}
+		}
+		matched.Records = append(matched.Records, record)
+	}
+
+	return matched, nil
+}
+
+// ListRecordsByNameAndType Returns only records of specified name and type
+func (client *Client) ListRecordsByNameAndType(zone string, name string, tpe string) ([]Record, error) {
+	return client.ListRecordsByNameAndTypeWithContext(context.Background(), zone, name, tpe)
+}
+
+// ListRecordsByNameAndTypeWithContext Returns only records of specified name and type
+func (client *Client) ListRecordsByNameAndTypeWithContext(ctx context.Context, zone string, name string, tpe string) ([]Record, error) {
+	rrSet, err := client.GetRecordSetWithContext(ctx, zone, name, tpe)
+	if err != nil {
+		return nil, err
+	}
+
+	if rrSet == nil {
+		return make([]Record, 0, 10), nil
+	}
+
+	records := make([]Record, 0, len(rrSet.Records))
+	for _, r := range rrSet.Records {
+		records = append(records, Record{
+			Name:    rrSet.Name,
+			Type:    rrSet.Type,
+			Content: r.Content,
+			TTL:     rrSet.TTL,
+		})
+	}
+
+	return records, nil
+}
+
+// ListRecordsByID returns only records that match the specified record IDentifier.
+func (client *Client) ListRecordsByID(zone string, recID string) ([]Record, error) {
+	return client.ListRecordsByIDWithContext(context.Background(), zone, recID)
+}
+
+// ListRecordsByIDWithContext returns only records that match the specified record IDentifier.
+func (client *Client) ListRecordsByIDWithContext(ctx context.Context, zone string, recID string) ([]Record, error) {
+	name, tpe, err := parseID(recID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ListRecordsByNameAndTypeWithContext(ctx, zone, name, tpe)
+}
+
+// RecordExists Checks if requested record exists in Zone
+func (client *Client) RecordExists(zone string, name string, tpe string) (bool, error) {
+	return client.RecordExistsWithContext(context.Background(), zone, name, tpe)
+}
+
+// RecordExistsWithContext Checks if requested record exists in Zone
+func (client *Client) RecordExistsWithContext(ctx context.Context, zone string, name string, tpe string) (bool, error) {
+	rrSet, err := client.GetRecordSetWithContext(ctx, zone, name, tpe)
+	if err != nil {
+		return false, err
+	}
+
+	return rrSet != nil, nil
+}
+
+// RecordExistsByID Checks if requested record exists in Zone by it's ID
+func (client *Client) RecordExistsByID(zone string, recID string) (bool, error) {
+	return client.RecordExistsByIDWithContext(context.Background(), zone, recID)
+}
+
+// RecordExistsByIDWithContext Checks if requested record exists in Zone by it's ID
+func (client *Client) RecordExistsByIDWithContext(ctx context.Context, zone string, recID string) (bool, error) {
+	name, tpe, err := parseID(recID)
+
+	if err != nil {
+		return false, err
+	}
+
+	return client.RecordExistsWithContext(ctx, zone, name, tpe)
+}
+
+// CreateRecord Creates new record with single content entry
+func (client *Client) CreateRecord(zone string, record Record) (string, error) {
+	return client.CreateRecordWithContext(context.Background(), zone, record)
+}
+
+// CreateRecordWithContext Creates new record with single content entry
+func (client *Client) CreateRecordWithContext(ctx context.Context, zone string, record Record) (string, error) {
+	reqBody, _ := json.Marshal(zonePatchRequest{
+		RecordSets: []ResourceRecordSet{
+			{
+				Name:       record.Name,
+				Type:       record.Type,
+				ChangeType: "REPLACE",
+				Records:    []Record{record},
+			},
+		},
+	})
+
+	req, err := client.newRequest(ctx, "PATCH", client.zonePath(zone), reqBody)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return "", decodeError(resp)
+	}
+
+	return record.ID(), nil
+}
+
+// ReplaceRecordSet Creates new record set in Zone
+func (client *Client) ReplaceRecordSet(zone string, rrSet ResourceRecordSet) (string, error) {
+	return client.ReplaceRecordSetWithContext(context.Background(), zone, rrSet)
+}
+
+// ReplaceRecordSetWithContext Creates new record set in Zone
+func (client *Client) ReplaceRecordSetWithContext(ctx context.Context, zone string, rrSet ResourceRecordSet) (string, error) {
+	rrSet.ChangeType = "REPLACE"
+
+	reqBody, _ := json.Marshal(zonePatchRequest{
+		RecordSets: []ResourceRecordSet{rrSet},
+	})
+
+	req, err := client.newRequest(ctx, "PATCH", client.zonePath(zone), reqBody)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return "", decodeError(resp)
+	}
+
+	return rrSet.ID(), nil
+}
+
+// DeleteRecordSet Deletes record set from Zone
+func (client *Client) DeleteRecordSet(zone string, name string, tpe string) error {
+	return client.DeleteRecordSetWithContext(context.Background(), zone, name, tpe)
+}
+
+// DeleteRecordSetWithContext Deletes record set from Zone
+func (client *Client) DeleteRecordSetWithContext(ctx context.Context, zone string, name string, tpe string) error {
+	reqBody, _ := json.Marshal(zonePatchRequest{
+		RecordSets: []ResourceRecordSet{
+			{
+				Name:       name,
+				Type:       tpe,
+				ChangeType: "DELETE",
+			},
+		},
+	})
+
+	req, err := client.newRequest(ctx, "PATCH", client.zonePath(zone), reqBody)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return decodeError(resp)
+	}
+
+	return nil
+}
+
+// DeleteRecordSetByID Deletes record from Zone by it's ID
+func (client *Client) DeleteRecordSetByID(zone string, recID string) error {
+	return client.DeleteRecordSetByIDWithContext(context.Background(), zone, recID)
+}
+
+// DeleteRecordSetByIDWithContext Deletes record from Zone by it's ID
+func (client *Client) DeleteRecordSetByIDWithContext(ctx context.Context, zone string, recID string) error {
+	name, tpe, err := parseID(recID)
+	if err != nil {
+		return err
+	}
+
+	return client.DeleteRecordSetWithContext(ctx, zone, name, tpe)
+}
+
+// ServerInfo describes one PowerDNS server instance exposed by the API, as
+// returned by GET /servers. A federated or virtual-hosted deployment may
+// expose more than one, each addressable via WithServerID.
+type ServerInfo struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	DaemonType string `json:"daemon_type"`
+	Version    string `json:"version"`
+	URL        string `json:"url"`
+	ConfigURL  string `json:"config_url"`
+	ZonesURL   string `json:"zones_url"`
+}
+
+// ListServers returns every server instance the API exposes, letting
+// callers discover federated PowerDNS instances before picking a ServerID.
+func (client *Client) ListServers() ([]ServerInfo, error) {
+	return client.ListServersWithContext(context.Background())
+}
+
+// ListServersWithContext is ListServers with a caller-supplied context.
+func (client *Client) ListServersWithContext(ctx context.Context) ([]ServerInfo, error) {
+	req, err := client.newRequestForVersion(ctx, 1, "GET", "/servers", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var servers []ServerInfo
+	if err := json.NewDecoder(resp.Body).Decode(&servers); err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
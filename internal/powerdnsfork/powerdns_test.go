@@ -0,0 +1,140 @@
+package powerdns
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// TestGetRecordSetWithContext_LegacyZone verifies that a v0 (3.4) server,
+// which reports records under "records" instead of "rrsets" and ignores the
+// rrset_name/rrset_type query filter, is still understood by
+// GetRecordSetWithContext instead of always looking empty.
+func TestGetRecordSetWithContext_LegacyZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		zoneInfo := ZoneInfo{
+			Name: "example.com.",
+			Records: []Record{
+				{Name: "www.example.com.", Type: "A", Content: "203.0.113.10", TTL: 300},
+				{Name: "other.example.com.", Type: "A", Content: "203.0.113.20", TTL: 300},
+			},
+		}
+		json.NewEncoder(w).Encode(zoneInfo)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "secret", WithAPIVersion(0))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	rrSet, err := client.GetRecordSetWithContext(context.Background(), "example.com.", "www.example.com.", "A")
+	if err != nil {
+		t.Fatalf("GetRecordSetWithContext: %v", err)
+	}
+
+	if rrSet == nil {
+		t.Fatal("expected a matching rrset, got nil")
+	}
+	if len(rrSet.Records) != 1 || rrSet.Records[0].Content != "203.0.113.10" {
+		t.Fatalf("unexpected rrset: %+v", rrSet)
+	}
+
+	exists, err := client.RecordExistsWithContext(context.Background(), "example.com.", "www.example.com.", "A")
+	if err != nil {
+		t.Fatalf("RecordExistsWithContext: %v", err)
+	}
+	if !exists {
+		t.Fatal("RecordExistsWithContext reported a record that does exist as missing")
+	}
+
+	records, err := client.ListRecordsByNameAndTypeWithContext(context.Background(), "example.com.", "missing.example.com.", "A")
+	if err != nil {
+		t.Fatalf("ListRecordsByNameAndTypeWithContext: %v", err)
+	}
+	if records == nil {
+		t.Fatal("expected a non-nil empty slice for a not-found rrset")
+	}
+	if len(records) != 0 {
+		t.Fatalf("expected no records, got %+v", records)
+	}
+}
+
+// TestDo_RetriesAfterRetryAfterHeader verifies that a 429 response with a
+// Retry-After header is retried (honoring the header instead of the default
+// backoff) and that the retried request resends the original body.
+func TestDo_RetriesAfterRetryAfterHeader(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		if string(body) != `{"name":"www.example.com."}` {
+			t.Errorf("retried request body = %q, want original body resent", body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "secret", WithAPIVersion(1))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	req, err := client.newRequest(context.Background(), "PATCH", "/zones/example.com.", []byte(`{"name":"www.example.com."}`))
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("final status = %d, want 200", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("server saw %d attempts, want 2", got)
+	}
+}
+
+// TestWithServerIDAndAPIVersion verifies that WithServerID addresses the
+// given server in request paths and that WithAPIVersion pins the API
+// version instead of probing /servers for it.
+func TestWithServerIDAndAPIVersion(t *testing.T) {
+	var gotPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/servers" {
+			t.Fatal("pinned client should not probe /servers for its API version")
+		}
+		gotPath = r.URL.Path
+		json.NewEncoder(w).Encode(ZoneInfo{})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "secret", WithServerID("dns-east"), WithAPIVersion(1))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := client.ListRecordsWithContext(context.Background(), "example.com."); err != nil {
+		t.Fatalf("ListRecordsWithContext: %v", err)
+	}
+
+	if want := "/api/v1/servers/dns-east/zones/example.com."; gotPath != want {
+		t.Fatalf("request path = %q, want %q", gotPath, want)
+	}
+}
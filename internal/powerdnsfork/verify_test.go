@@ -0,0 +1,83 @@
+package powerdns
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// TestVerifyQuery_SignsWithTSIG ensures verifyQuery actually attaches a TSIG
+// RR to the outgoing query instead of relying solely on dns.Client's
+// TsigSecret, which the miekg/dns package ignores unless the message itself
+// carries a TSIG record.
+func TestVerifyQuery_SignsWithTSIG(t *testing.T) {
+	const keyName = "verify-key."
+	const secret = "c2VjcmV0a2V5c2VjcmV0a2V5c2VjcmV0aw==" // arbitrary valid base64
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket: %v", err)
+	}
+
+	signed := make(chan bool, 1)
+
+	mux := dns.NewServeMux()
+	mux.HandleFunc(".", func(w dns.ResponseWriter, r *dns.Msg) {
+		signed <- r.IsTsig() != nil && w.TsigStatus() == nil
+
+		m := new(dns.Msg)
+		m.SetReply(r)
+		rr, _ := dns.NewRR("www.example.com. 300 IN A 203.0.113.10")
+		m.Answer = append(m.Answer, rr)
+		if r.IsTsig() != nil {
+			m.SetTsig(keyName, dns.HmacSHA256, 300, time.Now().Unix())
+		}
+		w.WriteMsg(m)
+	})
+
+	srv := &dns.Server{PacketConn: conn, Handler: mux, TsigSecret: map[string]string{keyName: secret}}
+	started := make(chan struct{})
+	srv.NotifyStartedFunc = func() { close(started) }
+
+	go srv.ActivateAndServe()
+	defer srv.Shutdown()
+
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("DNS server did not start in time")
+	}
+
+	client := &Client{
+		verifyOpts: &VerifyOptions{
+			TSIGKeyName:   keyName,
+			TSIGSecret:    secret,
+			TSIGAlgorithm: dns.HmacSHA256,
+			Timeout:       2 * time.Second,
+		},
+	}
+
+	rrSet := ResourceRecordSet{
+		Name: "www.example.com.",
+		Type: "A",
+		Records: []Record{
+			{Content: "203.0.113.10"},
+		},
+	}
+
+	if err := client.verifyQuery(context.Background(), conn.LocalAddr().String(), rrSet); err != nil {
+		t.Fatalf("verifyQuery: %v", err)
+	}
+
+	select {
+	case ok := <-signed:
+		if !ok {
+			t.Fatal("server did not see a validly-signed TSIG query")
+		}
+	default:
+		t.Fatal("handler was never invoked")
+	}
+}
@@ -0,0 +1,112 @@
+package powerdns
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// DefaultApplyMultiConcurrency bounds how many zones ApplyMulti patches concurrently.
+const DefaultApplyMultiConcurrency = 4
+
+// ChangeSet accumulates rrset changes for a single zone to be applied as one atomic PATCH.
+type ChangeSet struct {
+	recordSets []ResourceRecordSet
+}
+
+// NewChangeSet returns an empty ChangeSet ready to accumulate changes.
+func NewChangeSet() *ChangeSet {
+	return &ChangeSet{}
+}
+
+// Add stages the creation of a single record within its rrset.
+func (cs *ChangeSet) Add(record Record) *ChangeSet {
+	return cs.Replace(ResourceRecordSet{
+		Name:    record.Name,
+		Type:    record.Type,
+		TTL:     record.TTL,
+		Records: []Record{record},
+	})
+}
+
+// Replace stages a full rrset replacement.
+func (cs *ChangeSet) Replace(rrSet ResourceRecordSet) *ChangeSet {
+	rrSet.ChangeType = "REPLACE"
+	cs.recordSets = append(cs.recordSets, rrSet)
+	return cs
+}
+
+// Delete stages the removal of the rrset identified by name and type.
+func (cs *ChangeSet) Delete(name string, tpe string) *ChangeSet {
+	cs.recordSets = append(cs.recordSets, ResourceRecordSet{
+		Name:       name,
+		Type:       tpe,
+		ChangeType: "DELETE",
+	})
+	return cs
+}
+
+// Empty reports whether the ChangeSet has no staged changes.
+func (cs *ChangeSet) Empty() bool {
+	return len(cs.recordSets) == 0
+}
+
+// Apply sends every staged change for zone as a single atomic PATCH.
+func (cs *ChangeSet) Apply(ctx context.Context, client *Client, zone string) error {
+	if cs.Empty() {
+		return nil
+	}
+
+	reqBody, err := json.Marshal(zonePatchRequest{RecordSets: cs.recordSets})
+	if err != nil {
+		return err
+	}
+
+	req, err := client.newRequest(ctx, "PATCH", client.zonePath(zone), reqBody)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return decodeError(resp)
+	}
+
+	return nil
+}
+
+// ApplyMulti applies a ChangeSet per zone concurrently and returns a map of
+// zone to the error (if any) encountered applying it; one zone failing does
+// not stop the others from being applied.
+func (client *Client) ApplyMulti(ctx context.Context, changes map[string]*ChangeSet) map[string]error {
+	results := make(map[string]error, len(changes))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, DefaultApplyMultiConcurrency)
+
+	for zone, cs := range changes {
+		wg.Add(1)
+		go func(zone string, cs *ChangeSet) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := cs.Apply(ctx, client, zone)
+
+			mu.Lock()
+			results[zone] = err
+			mu.Unlock()
+		}(zone, cs)
+	}
+
+	wg.Wait()
+
+	return results
+}
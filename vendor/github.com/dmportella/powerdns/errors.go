@@ -0,0 +1,74 @@
+package powerdns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError Data representing a non-2xx response returned by a Client method.
+type APIError struct {
+	StatusCode int
+	Errors     []string
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("powerdns: http %d: %s", e.StatusCode, strings.Join(e.Errors, "; "))
+	}
+	return fmt.Sprintf("powerdns: http %d", e.StatusCode)
+}
+
+// Is lets errors.Is(err, ErrZoneNotFound) and friends match any APIError
+// with the corresponding status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrZoneNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// Sentinel errors usable with errors.Is against any error a Client method returns.
+var (
+	ErrUnauthorized = fmt.Errorf("powerdns: unauthorized")
+	ErrZoneNotFound = fmt.Errorf("powerdns: zone not found")
+	ErrConflict     = fmt.Errorf("powerdns: rrset conflict")
+	ErrValidation   = fmt.Errorf("powerdns: validation failed")
+)
+
+// v1ErrorResponse is the error shape returned by the API, which nests
+// per-field validation messages under "errors" alongside the top-level
+// "error" summary. The legacy (v0 / 3.4) API uses the same "error" field,
+// so this one struct parses both.
+type v1ErrorResponse struct {
+	ErrorMsg string   `json:"error"`
+	Errors   []string `json:"errors"`
+}
+
+// decodeError reads resp's body and builds the *APIError describing it.
+func decodeError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, Body: body}
+
+	var v1 v1ErrorResponse
+	if err := json.Unmarshal(body, &v1); err == nil && (v1.ErrorMsg != "" || len(v1.Errors) > 0) {
+		if len(v1.Errors) > 0 {
+			apiErr.Errors = v1.Errors
+		} else {
+			apiErr.Errors = []string{v1.ErrorMsg}
+		}
+	}
+
+	return apiErr
+}
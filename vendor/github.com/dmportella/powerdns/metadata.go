@@ -0,0 +1,148 @@
+package powerdns
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ZoneMetadata Data representing a single metadata entry of a Zone.
+type ZoneMetadata struct {
+	Kind     string   `json:"kind"`
+	Metadata []string `json:"metadata"`
+}
+
+// CryptoKey Data representing a DNSSEC key of a Zone.
+type CryptoKey struct {
+	ID         int64    `json:"id"`
+	KeyType    string   `json:"keytype"`
+	Active     bool     `json:"active"`
+	DNSKey     string   `json:"dnskey"`
+	DS         []string `json:"ds,omitempty"`
+	Algorithm  string   `json:"algorithm,omitempty"`
+	Bits       int      `json:"bits,omitempty"`
+	PrivateKey string   `json:"privatekey,omitempty"`
+}
+
+// ListMetadata Returns every metadata entry of zone.
+func (client *Client) ListMetadata(zone string) ([]ZoneMetadata, error) {
+	return client.ListMetadataWithContext(context.Background(), zone)
+}
+
+// ListMetadataWithContext is ListMetadata with a caller-supplied context.
+func (client *Client) ListMetadataWithContext(ctx context.Context, zone string) ([]ZoneMetadata, error) {
+	req, err := client.newRequest(ctx, "GET", client.zonePath(zone, "metadata"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var metadata []ZoneMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// SetMetadata creates or replaces the metadata entry identified by kind.
+func (client *Client) SetMetadata(zone string, metadata ZoneMetadata) error {
+	return client.SetMetadataWithContext(context.Background(), zone, metadata)
+}
+
+// SetMetadataWithContext is SetMetadata with a caller-supplied context.
+func (client *Client) SetMetadataWithContext(ctx context.Context, zone string, metadata ZoneMetadata) error {
+	reqBody, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	req, err := client.newRequest(ctx, "PUT", client.zonePath(zone, "metadata", metadata.Kind), reqBody)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return decodeError(resp)
+	}
+
+	return nil
+}
+
+// ListCryptoKeys returns the DNSSEC keys configured for zone.
+func (client *Client) ListCryptoKeys(zone string) ([]CryptoKey, error) {
+	return client.ListCryptoKeysWithContext(context.Background(), zone)
+}
+
+// ListCryptoKeysWithContext is ListCryptoKeys with a caller-supplied context.
+func (client *Client) ListCryptoKeysWithContext(ctx context.Context, zone string) ([]CryptoKey, error) {
+	req, err := client.newRequest(ctx, "GET", client.zonePath(zone, "cryptokeys"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var keys []CryptoKey
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// Notify triggers a NOTIFY of the zone's slaves.
+func (client *Client) Notify(zone string) error {
+	return client.NotifyWithContext(context.Background(), zone)
+}
+
+// NotifyWithContext is Notify with a caller-supplied context.
+func (client *Client) NotifyWithContext(ctx context.Context, zone string) error {
+	return client.zoneAction(ctx, zone, "notify")
+}
+
+// AXFRRetrieve requests that a slave zone retrieve a fresh copy of the zone
+// from its master via AXFR.
+func (client *Client) AXFRRetrieve(zone string) error {
+	return client.AXFRRetrieveWithContext(context.Background(), zone)
+}
+
+// AXFRRetrieveWithContext is AXFRRetrieve with a caller-supplied context.
+func (client *Client) AXFRRetrieveWithContext(ctx context.Context, zone string) error {
+	return client.zoneAction(ctx, zone, "axfr-retrieve")
+}
+
+// zoneAction issues a PUT against the given zone sub-resource, used by the
+// parameterless zone actions (notify, axfr-retrieve).
+func (client *Client) zoneAction(ctx context.Context, zone string, action string) error {
+	req, err := client.newRequest(ctx, "PUT", client.zonePath(zone, action), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 204 {
+		return decodeError(resp)
+	}
+
+	return nil
+}
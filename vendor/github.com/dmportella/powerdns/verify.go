@@ -0,0 +1,261 @@
+package powerdns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// defaultVerifyPollInterval is the base delay between propagation checks
+// performed by the …AndVerify methods.
+const defaultVerifyPollInterval = 2 * time.Second
+
+// VerifyOptions configures TSIG-signed verification that a PATCH has
+// propagated to a zone's authoritative nameservers.
+type VerifyOptions struct {
+	// TSIGKeyName, TSIGSecret, and TSIGAlgorithm authenticate the query
+	// sent to each nameserver. TSIGSecret is the base64-encoded secret
+	// as used by github.com/miekg/dns. TSIGAlgorithm defaults to
+	// dns.HmacSHA256 when empty.
+	TSIGKeyName   string
+	TSIGSecret    string
+	TSIGAlgorithm string
+
+	// Nameservers lists the "host:port" addresses to query directly.
+	Nameservers []string
+
+	// Timeout bounds a single query to a single nameserver.
+	Timeout time.Duration
+
+	// UseAXFR verifies via a full zone transfer instead of a plain query;
+	// needed to confirm a deletion, since a query for a removed rrset looks
+	// identical to one that never existed.
+	UseAXFR bool
+}
+
+// WithVerifyOptions enables the Verify subsystem and the …AndVerify method
+// variants.
+func WithVerifyOptions(opts VerifyOptions) Option {
+	return func(client *Client) {
+		client.verifyOpts = &opts
+	}
+}
+
+// ErrVerificationNotConfigured is returned by Verify and the …AndVerify
+// methods when the Client was built without WithVerifyOptions.
+var ErrVerificationNotConfigured = fmt.Errorf("powerdns: Verify requires a Client built with WithVerifyOptions")
+
+// Verify queries every configured nameserver directly for rrSet and returns
+// a status map keyed by nameserver, nil meaning the answer matched.
+func (client *Client) Verify(ctx context.Context, zone string, rrSet ResourceRecordSet) (map[string]error, error) {
+	if client.verifyOpts == nil {
+		return nil, ErrVerificationNotConfigured
+	}
+
+	opts := client.verifyOpts
+	results := make(map[string]error, len(opts.Nameservers))
+
+	for _, nameserver := range opts.Nameservers {
+		if opts.UseAXFR {
+			results[nameserver] = client.verifyAXFR(ctx, zone, nameserver, rrSet)
+		} else {
+			results[nameserver] = client.verifyQuery(ctx, nameserver, rrSet)
+		}
+	}
+
+	return results, nil
+}
+
+func (client *Client) verifyQuery(ctx context.Context, nameserver string, rrSet ResourceRecordSet) error {
+	qtype, ok := dns.StringToType[rrSet.Type]
+	if !ok {
+		return fmt.Errorf("powerdns: unknown record type %q", rrSet.Type)
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(rrSet.Name), qtype)
+
+	opts := client.verifyOpts
+	if opts.TSIGKeyName != "" {
+		// dnsClient.TsigSecret alone does not sign anything; the RR has to
+		// be attached to the message for the client to actually sign it.
+		msg.SetTsig(dns.Fqdn(opts.TSIGKeyName), tsigAlgorithm(opts.TSIGAlgorithm), 300, time.Now().Unix())
+	}
+
+	dnsClient := client.tsigDNSClient()
+
+	in, _, err := dnsClient.ExchangeContext(ctx, msg, nameserver)
+	if err != nil {
+		return fmt.Errorf("powerdns: querying %s: %w", nameserver, err)
+	}
+
+	if in.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("powerdns: %s returned %s", nameserver, dns.RcodeToString[in.Rcode])
+	}
+
+	want := make(map[string]struct{}, len(rrSet.Records))
+	for _, r := range rrSet.Records {
+		want[r.Content] = struct{}{}
+	}
+
+	got := make(map[string]struct{}, len(in.Answer))
+	for _, rr := range in.Answer {
+		got[recordContent(rr)] = struct{}{}
+	}
+
+	if len(want) != len(got) {
+		return fmt.Errorf("powerdns: %s answered with %d records, want %d", nameserver, len(got), len(want))
+	}
+
+	for content := range want {
+		if _, ok := got[content]; !ok {
+			return fmt.Errorf("powerdns: %s is missing record content %q", nameserver, content)
+		}
+	}
+
+	return nil
+}
+
+func (client *Client) verifyAXFR(ctx context.Context, zone string, nameserver string, rrSet ResourceRecordSet) error {
+	opts := client.verifyOpts
+
+	msg := new(dns.Msg)
+	msg.SetAxfr(dns.Fqdn(zone))
+
+	transfer := &dns.Transfer{}
+	if opts.TSIGKeyName != "" {
+		keyName := dns.Fqdn(opts.TSIGKeyName)
+		msg.SetTsig(keyName, tsigAlgorithm(opts.TSIGAlgorithm), 300, time.Now().Unix())
+		transfer.TsigSecret = map[string]string{keyName: opts.TSIGSecret}
+	}
+
+	channel, err := transfer.In(msg, nameserver)
+	if err != nil {
+		return fmt.Errorf("powerdns: AXFR from %s: %w", nameserver, err)
+	}
+
+	want := make(map[string]struct{}, len(rrSet.Records))
+	for _, r := range rrSet.Records {
+		want[r.Content] = struct{}{}
+	}
+
+	for envelope := range channel {
+		if envelope.Error != nil {
+			return fmt.Errorf("powerdns: AXFR from %s: %w", nameserver, envelope.Error)
+		}
+
+		for _, rr := range envelope.RR {
+			header := rr.Header()
+			if header.Name != dns.Fqdn(rrSet.Name) || dns.TypeToString[header.Rrtype] != rrSet.Type {
+				continue
+			}
+
+			delete(want, recordContent(rr))
+		}
+	}
+
+	if len(want) > 0 {
+		return fmt.Errorf("powerdns: AXFR from %s did not include %d expected record(s)", nameserver, len(want))
+	}
+
+	return nil
+}
+
+func (client *Client) tsigDNSClient() *dns.Client {
+	opts := client.verifyOpts
+
+	dnsClient := &dns.Client{Timeout: opts.Timeout}
+	if opts.TSIGKeyName != "" {
+		keyName := dns.Fqdn(opts.TSIGKeyName)
+		dnsClient.TsigSecret = map[string]string{keyName: opts.TSIGSecret}
+	}
+
+	return dnsClient
+}
+
+func tsigAlgorithm(algorithm string) string {
+	if algorithm == "" {
+		return dns.HmacSHA256
+	}
+	return algorithm
+}
+
+// recordContent renders rr's data portion the same way PowerDNS reports it
+// in a Record.Content, so the two can be compared directly.
+func recordContent(rr dns.RR) string {
+	full := rr.String()
+	header := rr.Header().String()
+	return full[len(header):]
+}
+
+// pollVerify retries Verify with backoff until every nameserver reports
+// success or deadline elapses, returning the last status map observed.
+func (client *Client) pollVerify(ctx context.Context, zone string, rrSet ResourceRecordSet, deadline time.Duration) (map[string]error, error) {
+	ctx, cancel := context.WithTimeout(ctx, deadline)
+	defer cancel()
+
+	var last map[string]error
+	for {
+		results, err := client.Verify(ctx, zone, rrSet)
+		if err != nil {
+			return nil, err
+		}
+		last = results
+
+		if allVerified(results) {
+			return results, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-time.After(defaultVerifyPollInterval):
+		}
+	}
+}
+
+func allVerified(results map[string]error) bool {
+	for _, err := range results {
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ReplaceRecordSetAndVerify is ReplaceRecordSetWithContext followed by a
+// poll of Verify until every nameserver serves the new rrset or deadline elapses.
+func (client *Client) ReplaceRecordSetAndVerify(ctx context.Context, zone string, rrSet ResourceRecordSet, deadline time.Duration) (map[string]error, error) {
+	if client.verifyOpts == nil {
+		return nil, ErrVerificationNotConfigured
+	}
+
+	if _, err := client.ReplaceRecordSetWithContext(ctx, zone, rrSet); err != nil {
+		return nil, err
+	}
+
+	return client.pollVerify(ctx, zone, rrSet, deadline)
+}
+
+// CreateRecordAndVerify is CreateRecordWithContext followed by a poll of
+// Verify until every nameserver serves the new record or deadline elapses.
+func (client *Client) CreateRecordAndVerify(ctx context.Context, zone string, record Record, deadline time.Duration) (map[string]error, error) {
+	if client.verifyOpts == nil {
+		return nil, ErrVerificationNotConfigured
+	}
+
+	if _, err := client.CreateRecordWithContext(ctx, zone, record); err != nil {
+		return nil, err
+	}
+
+	rrSet := ResourceRecordSet{
+		Name:    record.Name,
+		Type:    record.Type,
+		TTL:     record.TTL,
+		Records: []Record{record},
+	}
+
+	return client.pollVerify(ctx, zone, rrSet, deadline)
+}